@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wesm/roborev/internal/notes"
+)
+
+func notesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notes",
+		Short: "Manage reviews stored as git notes",
+	}
+	cmd.AddCommand(notesSyncCmd())
+	return cmd
+}
+
+func notesSyncCmd() *cobra.Command {
+	var remote string
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Fetch and push review notes with a remote",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			repoPath, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("get working directory: %w", err)
+			}
+			if err := notes.Sync(repoPath, remote); err != nil {
+				return fmt.Errorf("sync review notes: %w", err)
+			}
+			fmt.Printf("Synced review notes (%s) with %s\n", notes.DefaultRef, remote)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&remote, "remote", "origin", "remote to sync review notes with")
+
+	return cmd
+}
@@ -0,0 +1,136 @@
+// Package cistatus retrieves recent CI/build status for a commit so it can
+// be surfaced as review prompt context, in the spirit of git-appraise's
+// ci.Report notes convention.
+package cistatus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Status is the outcome of a CI run.
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusFailed  Status = "failed"
+	StatusPending Status = "pending"
+)
+
+// Report is a single CI/build run against a commit.
+type Report struct {
+	Agent     string    `json:"agent"`
+	URL       string    `json:"url"`
+	Status    Status    `json:"status"`
+	Summary   string    `json:"summary"`
+	StartedAt time.Time `json:"started_at"`
+}
+
+// Provider fetches CI reports for a commit. Implementations return an empty
+// slice, not an error, when there's simply nothing to report.
+type Provider interface {
+	GetReports(repoPath, sha string) ([]Report, error)
+}
+
+// NotesRef is the git-notes ref git-appraise-style CI tooling writes
+// reports to: one JSON report per line.
+const NotesRef = "refs/notes/devtools/ci"
+
+// NotesProvider reads CI reports from git notes under NotesRef.
+type NotesProvider struct{}
+
+// GetReports implements Provider.
+func (NotesProvider) GetReports(repoPath, sha string) ([]Report, error) {
+	cmd := exec.Command("git", "-C", repoPath, "notes", "--ref", NotesRef, "show", sha)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(stderr.String(), "no note found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("git notes show: %w: %s", err, stderr.String())
+	}
+
+	var reports []Report
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		var r Report
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			continue
+		}
+		reports = append(reports, r)
+	}
+	return reports, nil
+}
+
+// GitHubProvider reads CI status from GitHub Actions via the gh CLI.
+type GitHubProvider struct{}
+
+type ghRun struct {
+	WorkflowName string    `json:"workflowName"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	URL          string    `json:"url"`
+	DisplayTitle string    `json:"displayTitle"`
+	StartedAt    time.Time `json:"startedAt"`
+}
+
+// GetReports implements Provider.
+func (GitHubProvider) GetReports(repoPath, sha string) ([]Report, error) {
+	cmd := exec.Command("gh", "run", "list",
+		"--commit", sha,
+		"--json", "workflowName,status,conclusion,url,displayTitle,startedAt")
+	cmd.Dir = repoPath
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("gh run list: %w: %s", err, stderr.String())
+	}
+
+	var runs []ghRun
+	if err := json.Unmarshal(stdout.Bytes(), &runs); err != nil {
+		return nil, fmt.Errorf("decode gh run list: %w", err)
+	}
+
+	reports := make([]Report, 0, len(runs))
+	for _, r := range runs {
+		reports = append(reports, Report{
+			Agent:     r.WorkflowName,
+			URL:       r.URL,
+			Status:    ghRunStatus(r),
+			Summary:   r.DisplayTitle,
+			StartedAt: r.StartedAt,
+		})
+	}
+	return reports, nil
+}
+
+func ghRunStatus(r ghRun) Status {
+	if r.Status != "completed" {
+		return StatusPending
+	}
+	if r.Conclusion == "success" {
+		return StatusSuccess
+	}
+	return StatusFailed
+}
+
+// HasGitHubRemote reports whether repoPath's origin remote points at
+// github.com, so callers can pick GitHubProvider only when it applies.
+func HasGitHubRemote(repoPath string) bool {
+	cmd := exec.Command("git", "-C", repoPath, "remote", "get-url", "origin")
+	out, err := cmd.Output()
+	if err != nil {
+		return false
+	}
+	return strings.Contains(string(out), "github.com")
+}
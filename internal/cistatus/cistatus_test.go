@@ -0,0 +1,25 @@
+package cistatus
+
+import "testing"
+
+func TestGhRunStatus(t *testing.T) {
+	cases := []struct {
+		name string
+		run  ghRun
+		want Status
+	}{
+		{"in progress", ghRun{Status: "in_progress"}, StatusPending},
+		{"queued", ghRun{Status: "queued"}, StatusPending},
+		{"completed success", ghRun{Status: "completed", Conclusion: "success"}, StatusSuccess},
+		{"completed failure", ghRun{Status: "completed", Conclusion: "failure"}, StatusFailed},
+		{"completed cancelled", ghRun{Status: "completed", Conclusion: "cancelled"}, StatusFailed},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := ghRunStatus(c.run); got != c.want {
+				t.Errorf("ghRunStatus(%+v) = %s, want %s", c.run, got, c.want)
+			}
+		})
+	}
+}
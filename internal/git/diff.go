@@ -0,0 +1,101 @@
+// Package git wraps the git plumbing commands used to build review prompts
+// and render diffs. This file adds diff retrieval and parsing for the TUI's
+// side-by-side diff viewer.
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GetDiff returns the unified diff for ref. For a single commit it shows
+// that commit's own changes; for a range (see IsRange) it diffs base..tip.
+func GetDiff(repoPath, ref string) (string, error) {
+	var cmd *exec.Cmd
+	if IsRange(ref) {
+		cmd = exec.Command("git", "-C", repoPath, "diff", "-U3", ref)
+	} else {
+		cmd = exec.Command("git", "-C", repoPath, "show", "--format=", "-U3", ref)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git diff: %w: %s", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+// Hunk is one @@ ... @@ block of a unified diff, scoped to a single file.
+type Hunk struct {
+	File     string
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []string
+}
+
+var hunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParseHunks splits a unified diff (as returned by GetDiff) into per-file
+// hunks so callers can render them side by side or jump to a file:line.
+func ParseHunks(diff string) ([]Hunk, error) {
+	var hunks []Hunk
+	var currentFile string
+	var current *Hunk
+
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			if current != nil {
+				hunks = append(hunks, *current)
+				current = nil
+			}
+		case strings.HasPrefix(line, "+++ b/"):
+			currentFile = strings.TrimPrefix(line, "+++ b/")
+		case strings.HasPrefix(line, "@@"):
+			if current != nil {
+				hunks = append(hunks, *current)
+			}
+			m := hunkHeader.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("parse hunk header %q", line)
+			}
+			oldStart, _ := strconv.Atoi(m[1])
+			newStart, _ := strconv.Atoi(m[3])
+			oldLines, newLines := 1, 1
+			if m[2] != "" {
+				oldLines, _ = strconv.Atoi(m[2])
+			}
+			if m[4] != "" {
+				newLines, _ = strconv.Atoi(m[4])
+			}
+			current = &Hunk{
+				File:     currentFile,
+				OldStart: oldStart,
+				OldLines: oldLines,
+				NewStart: newStart,
+				NewLines: newLines,
+			}
+			current.Lines = append(current.Lines, line)
+		case current != nil:
+			current.Lines = append(current.Lines, line)
+		}
+	}
+	if current != nil {
+		hunks = append(hunks, *current)
+	}
+
+	return hunks, nil
+}
+
+// Contains reports whether the hunk's new-file range covers line.
+func (h Hunk) Contains(line int) bool {
+	return line >= h.NewStart && line < h.NewStart+h.NewLines
+}
@@ -0,0 +1,73 @@
+package git
+
+import "testing"
+
+const twoFileDiff = `diff --git a/foo.go b/foo.go
+index 1111111..2222222 100644
+--- a/foo.go
++++ b/foo.go
+@@ -1,3 +1,4 @@
+ package foo
+
+-func Foo() {}
++func Foo() {}
++func Bar() {}
+diff --git a/bar.go b/bar.go
+index 3333333..4444444 100644
+--- a/bar.go
++++ b/bar.go
+@@ -1,2 +1,2 @@
+ package bar
+-var x = 1
++var x = 2
+`
+
+func TestParseHunksMultiFile(t *testing.T) {
+	hunks, err := ParseHunks(twoFileDiff)
+	if err != nil {
+		t.Fatalf("ParseHunks: %v", err)
+	}
+	if len(hunks) != 2 {
+		t.Fatalf("got %d hunks, want 2", len(hunks))
+	}
+
+	foo, bar := hunks[0], hunks[1]
+	if foo.File != "foo.go" {
+		t.Errorf("hunks[0].File = %q, want foo.go", foo.File)
+	}
+	if bar.File != "bar.go" {
+		t.Errorf("hunks[1].File = %q, want bar.go", bar.File)
+	}
+
+	for _, l := range foo.Lines {
+		if l == "diff --git a/bar.go b/bar.go" || l == "index 3333333..4444444 100644" {
+			t.Errorf("foo.go hunk leaked bar.go's header line: %q", l)
+		}
+	}
+}
+
+func TestParseHunksSingleFile(t *testing.T) {
+	diff := `diff --git a/foo.go b/foo.go
+index 1111111..2222222 100644
+--- a/foo.go
++++ b/foo.go
+@@ -1,2 +1,2 @@
+ package foo
+-func Foo() {}
++func Foo() { return }
+`
+	hunks, err := ParseHunks(diff)
+	if err != nil {
+		t.Fatalf("ParseHunks: %v", err)
+	}
+	if len(hunks) != 1 {
+		t.Fatalf("got %d hunks, want 1", len(hunks))
+	}
+	h := hunks[0]
+	if h.File != "foo.go" || h.OldStart != 1 || h.NewStart != 1 || h.NewLines != 2 {
+		t.Errorf("unexpected hunk: %+v", h)
+	}
+	if !h.Contains(1) || !h.Contains(2) || h.Contains(3) {
+		t.Errorf("Contains mismatched range for %+v", h)
+	}
+}
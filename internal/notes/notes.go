@@ -0,0 +1,230 @@
+// Package notes stores reviews as git notes so they travel with the
+// repository instead of living only in the daemon's local database.
+//
+// Notes are written under DefaultRef, one JSON line per (commit, agent)
+// review: AppendReview replaces any existing line for the same agent so
+// re-reviewing a commit doesn't pile up duplicates. Across machines, the
+// "cat_sort_uniq" notes merge strategy sorts and dedups lines byte-for-byte,
+// so two reviewers appending at the same time end up with the union of both
+// notes rather than one clobbering the other.
+package notes
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultRef is the git-notes ref roborev reviews are stored under.
+const DefaultRef = "refs/notes/roborev/reviews"
+
+// Review is the JSON-serializable form of a review as stored in git notes.
+type Review struct {
+	Key       string    `json:"key"`
+	Agent     string    `json:"agent"`
+	Output    string    `json:"output"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AppendReview serializes a review and records it as a note on sha under
+// DefaultRef, replacing any prior note line from the same agent so repeated
+// reviews of the same commit stay at one line per (commit, agent) instead
+// of piling up indefinitely. Different agents' lines are preserved, so
+// multiple agents (and, after Sync, multiple reviewers) still accumulate
+// notes on the same commit.
+func AppendReview(repoPath, sha, agent, output string) error {
+	r := Review{Agent: agent, Output: output, Timestamp: time.Now().UTC()}
+	r.Key = reviewKey(r)
+
+	line, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("marshal review: %w", err)
+	}
+
+	existing, err := ListReviews(repoPath, sha)
+	if err != nil {
+		return fmt.Errorf("list existing reviews: %w", err)
+	}
+
+	lines := make([]string, 0, len(existing)+1)
+	for _, prev := range existing {
+		if prev.Agent == agent {
+			continue
+		}
+		prevLine, err := json.Marshal(prev)
+		if err != nil {
+			return fmt.Errorf("marshal existing review: %w", err)
+		}
+		lines = append(lines, string(prevLine))
+	}
+	lines = append(lines, string(line))
+
+	cmd := exec.Command("git", "-C", repoPath, "notes", "--ref", DefaultRef, "add", "-f", "-m", strings.Join(lines, "\n"), sha)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git notes add: %w: %s", err, stderr.String())
+	}
+	return nil
+}
+
+// ListReviews returns every review note attached to sha, oldest first.
+// Malformed lines (e.g. a stray merge marker) are skipped rather than
+// failing the whole read.
+func ListReviews(repoPath, sha string) ([]Review, error) {
+	cmd := exec.Command("git", "-C", repoPath, "notes", "--ref", DefaultRef, "show", sha)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(stderr.String(), "no note found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("git notes show: %w: %s", err, stderr.String())
+	}
+
+	var reviews []Review
+	scanner := bufio.NewScanner(&stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var r Review
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			continue
+		}
+		reviews = append(reviews, r)
+	}
+
+	sort.Slice(reviews, func(i, j int) bool {
+		return reviews[i].Timestamp.Before(reviews[j].Timestamp)
+	})
+
+	return reviews, nil
+}
+
+// remoteRef is where incoming review notes land during Sync before being
+// merged into DefaultRef. Fetching straight into DefaultRef would fail
+// whenever the local ref already has unpushed notes (not a fast-forward),
+// which is the common case for a reviewer who has been working offline.
+const remoteRef = "refs/notes/roborev-remote/reviews"
+
+// Sync fetches remote review notes into remoteRef, merges them into
+// DefaultRef with the cat_sort_uniq strategy, and pushes the result back.
+// cat_sort_uniq sorts and dedups lines by their full text, so two reviewers
+// appending notes concurrently end up with the union of both rather than
+// one clobbering the other.
+func Sync(repoPath, remote string) error {
+	if err := run(repoPath, "config", "notes.mergeStrategy", "cat_sort_uniq"); err != nil {
+		return fmt.Errorf("configure notes merge strategy: %w", err)
+	}
+
+	fetchRefspec := fmt.Sprintf("+%s:%s", DefaultRef, remoteRef)
+	if err := run(repoPath, "fetch", remote, fetchRefspec); err != nil {
+		return fmt.Errorf("fetch review notes: %w", err)
+	}
+
+	if err := run(repoPath, "notes", "--ref", DefaultRef, "merge", "-s", "cat_sort_uniq", remoteRef); err != nil {
+		return fmt.Errorf("merge review notes: %w", err)
+	}
+
+	pushRefspec := fmt.Sprintf("%s:%s", DefaultRef, DefaultRef)
+	if err := run(repoPath, "push", remote, pushRefspec); err != nil {
+		return fmt.Errorf("push review notes: %w", err)
+	}
+
+	return nil
+}
+
+func run(repoPath string, args ...string) error {
+	cmd := exec.Command("git", append([]string{"-C", repoPath}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return nil
+}
+
+// reviewKey builds a stable key from timestamp, agent, and content hash so
+// that cat_sort_uniq can sort and dedup lines deterministically.
+func reviewKey(r Review) string {
+	hash := sha256.Sum256([]byte(r.Output))
+	return fmt.Sprintf("%d-%s-%x", r.Timestamp.UnixNano(), r.Agent, hash[:8])
+}
+
+// VerdictsRef is the git-notes ref human review verdicts are stored under.
+const VerdictsRef = "refs/notes/roborev/verdicts"
+
+// Verdict is the JSON-serializable form of a human sign-off on a review.
+type Verdict struct {
+	Reviewer  string    `json:"reviewer"`
+	Decision  string    `json:"decision"`
+	Note      string    `json:"note"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// AppendVerdict appends a verdict note on sha under VerdictsRef, recorded
+// under the reviewer's configured git identity so it propagates with the
+// repo. The reviewer field is taken as given by the caller, and the note is
+// not cryptographically signed: git notes ignores commit.gpgSign, so the
+// notes commit carries no signature.
+func AppendVerdict(repoPath, sha, reviewer, decision, note string) error {
+	v := Verdict{Reviewer: reviewer, Decision: decision, Note: note, Timestamp: time.Now().UTC()}
+	line, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal verdict: %w", err)
+	}
+
+	cmd := exec.Command("git", "-C", repoPath,
+		"notes", "--ref", VerdictsRef, "append", "-m", string(line), sha)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git notes append: %w: %s", err, stderr.String())
+	}
+	return nil
+}
+
+// ListVerdicts returns every verdict note attached to sha, oldest first.
+func ListVerdicts(repoPath, sha string) ([]Verdict, error) {
+	cmd := exec.Command("git", "-C", repoPath, "notes", "--ref", VerdictsRef, "show", sha)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if strings.Contains(stderr.String(), "no note found") {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("git notes show: %w: %s", err, stderr.String())
+	}
+
+	var verdicts []Verdict
+	scanner := bufio.NewScanner(&stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var v Verdict
+		if err := json.Unmarshal([]byte(line), &v); err != nil {
+			continue
+		}
+		verdicts = append(verdicts, v)
+	}
+
+	sort.Slice(verdicts, func(i, j int) bool {
+		return verdicts[i].Timestamp.Before(verdicts[j].Timestamp)
+	})
+
+	return verdicts, nil
+}
@@ -0,0 +1,79 @@
+package notes
+
+import (
+	"os/exec"
+	"testing"
+)
+
+// initRepo creates a throwaway git repo with one commit and returns its
+// path and the commit sha, so tests can exercise AppendReview/ListReviews
+// against real git-notes plumbing.
+func initRepo(t *testing.T) (repoPath, sha string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return string(out)
+	}
+
+	run("init", "-q")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	run("commit", "--allow-empty", "-q", "-m", "initial")
+
+	return dir, trimNewline(run("rev-parse", "HEAD"))
+}
+
+func trimNewline(s string) string {
+	for len(s) > 0 && (s[len(s)-1] == '\n' || s[len(s)-1] == '\r') {
+		s = s[:len(s)-1]
+	}
+	return s
+}
+
+func TestAppendReviewDedupsPerAgent(t *testing.T) {
+	repoPath, sha := initRepo(t)
+
+	if err := AppendReview(repoPath, sha, "agent-a", "first pass"); err != nil {
+		t.Fatalf("AppendReview (first): %v", err)
+	}
+	if err := AppendReview(repoPath, sha, "agent-a", "second pass"); err != nil {
+		t.Fatalf("AppendReview (second): %v", err)
+	}
+	if err := AppendReview(repoPath, sha, "agent-b", "other agent"); err != nil {
+		t.Fatalf("AppendReview (other agent): %v", err)
+	}
+
+	reviews, err := ListReviews(repoPath, sha)
+	if err != nil {
+		t.Fatalf("ListReviews: %v", err)
+	}
+	if len(reviews) != 2 {
+		t.Fatalf("got %d reviews, want 2 (one per agent); reviews=%+v", len(reviews), reviews)
+	}
+
+	var gotA, gotB bool
+	for _, r := range reviews {
+		switch r.Agent {
+		case "agent-a":
+			gotA = true
+			if r.Output != "second pass" {
+				t.Errorf("agent-a's output = %q, want the latest review %q", r.Output, "second pass")
+			}
+		case "agent-b":
+			gotB = true
+			if r.Output != "other agent" {
+				t.Errorf("agent-b's output = %q, want %q", r.Output, "other agent")
+			}
+		}
+	}
+	if !gotA || !gotB {
+		t.Errorf("expected reviews from both agent-a and agent-b, got %+v", reviews)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/wesm/roborev/internal/cistatus"
 	"github.com/wesm/roborev/internal/git"
+	"github.com/wesm/roborev/internal/notes"
 	"github.com/wesm/roborev/internal/storage"
 )
 
@@ -16,6 +18,8 @@ const SystemPromptSingle = `You are a code reviewer. Review the git commit shown
 3. **Testing gaps**: Missing unit tests, edge cases not covered, e2e/integration test gaps
 4. **Regressions**: Changes that might break existing functionality
 5. **Code quality**: Duplication that should be refactored, overly complex logic, unclear naming
+6. **CI correlation**: If CI status is provided below, cross-reference any failing checks
+   against the diff to say whether this commit likely caused or fixes the failure
 
 After reviewing against all criteria above:
 
@@ -25,7 +29,13 @@ If you find issues, list them with:
 - A brief explanation of the problem and suggested fix
 
 If you find no issues, confirm you checked for bugs, security issues, testing gaps,
-regressions, and code quality concerns, then briefly summarize what the commit does.`
+regressions, and code quality concerns, then briefly summarize what the commit does.
+
+After your prose review, append a fenced ` + "```roborev-json" + ` block with a single JSON
+object of the form {"findings":[{"severity":"high|medium|low","file":"...",
+"line_start":1,"line_end":1,"category":"...","message":"...","suggestion":"..."}],
+"summary":"..."}. Include one entry per issue found; use an empty findings array if
+there are none.`
 
 // SystemPromptRange is the base instruction for commit range reviews
 const SystemPromptRange = `You are a code reviewer. Review the git commit range shown below for:
@@ -35,6 +45,8 @@ const SystemPromptRange = `You are a code reviewer. Review the git commit range
 3. **Testing gaps**: Missing unit tests, edge cases not covered, e2e/integration test gaps
 4. **Regressions**: Changes that might break existing functionality
 5. **Code quality**: Duplication that should be refactored, overly complex logic, unclear naming
+6. **CI correlation**: If CI status is provided below, cross-reference any failing checks
+   against the diff to say whether these commits likely caused or fix the failure
 
 After reviewing against all criteria above:
 
@@ -44,7 +56,13 @@ If you find issues, list them with:
 - A brief explanation of the problem and suggested fix
 
 If you find no issues, confirm you checked for bugs, security issues, testing gaps,
-regressions, and code quality concerns, then briefly summarize what the commits do.`
+regressions, and code quality concerns, then briefly summarize what the commits do.
+
+After your prose review, append a fenced ` + "```roborev-json" + ` block with a single JSON
+object of the form {"findings":[{"severity":"high|medium|low","file":"...",
+"line_start":1,"line_end":1,"category":"...","message":"...","suggestion":"..."}],
+"summary":"..."}. Include one entry per issue found; use an empty findings array if
+there are none.`
 
 // PreviousReviewsHeader introduces the previous reviews section
 const PreviousReviewsHeader = `
@@ -54,6 +72,14 @@ The following are reviews of recent commits in this repository. Use them as cont
 to understand ongoing work and to check if the current commit addresses previous feedback.
 `
 
+// CIStatusHeader introduces the CI status section
+const CIStatusHeader = `
+## CI Status
+
+The following are recent CI/build runs against this commit. Cross-reference any
+failures against the diff.
+`
+
 // ReviewContext holds a commit SHA and its associated review (if any)
 type ReviewContext struct {
 	SHA    string
@@ -62,7 +88,8 @@ type ReviewContext struct {
 
 // Builder constructs review prompts
 type Builder struct {
-	db *storage.DB
+	db        *storage.DB
+	ciContext bool
 }
 
 // NewBuilder creates a new prompt builder
@@ -70,6 +97,13 @@ func NewBuilder(db *storage.DB) *Builder {
 	return &Builder{db: db}
 }
 
+// SetCIContext enables or disables the "## CI Status" prompt section. It
+// defaults to disabled so repos without CI notes or a GitHub remote pay
+// nothing for the extra subprocess calls.
+func (b *Builder) SetCIContext(enabled bool) {
+	b.ciContext = enabled
+}
+
 // Build constructs a review prompt for a commit or range with context from previous reviews
 func (b *Builder) Build(repoPath, gitRef string, repoID int64, contextCount int) (string, error) {
 	if git.IsRange(gitRef) {
@@ -97,6 +131,11 @@ func (b *Builder) buildSinglePrompt(repoPath, sha string, repoID int64, contextC
 		}
 	}
 
+	// Get CI status if enabled
+	if b.ciContext {
+		b.writeCIStatus(&sb, repoPath, sha)
+	}
+
 	// Current commit section
 	shortSHA := sha
 	if len(shortSHA) > 7 {
@@ -133,6 +172,11 @@ func (b *Builder) buildRangePrompt(repoPath, rangeRef string, repoID int64, cont
 		return "", fmt.Errorf("get range commits: %w", err)
 	}
 
+	// Get CI status for the tip of the range if enabled
+	if b.ciContext && len(commits) > 0 {
+		b.writeCIStatus(&sb, repoPath, commits[len(commits)-1])
+	}
+
 	// Commit range section
 	sb.WriteString("## Commit Range\n\n")
 	sb.WriteString(fmt.Sprintf("Reviewing %d commits:\n\n", len(commits)))
@@ -177,6 +221,39 @@ func (b *Builder) writePreviousReviews(sb *strings.Builder, contexts []ReviewCon
 	}
 }
 
+// writeCIStatus writes the CI status section to the builder, trying git
+// notes first and falling back to GitHub Actions when no notes are present.
+func (b *Builder) writeCIStatus(sb *strings.Builder, repoPath, sha string) {
+	reports := b.getCIReports(repoPath, sha)
+	if len(reports) == 0 {
+		return
+	}
+
+	sb.WriteString(CIStatusHeader)
+	sb.WriteString("\n")
+	for _, r := range reports {
+		sb.WriteString(fmt.Sprintf("- %s: %s - %s\n", r.Agent, r.Status, r.Summary))
+	}
+	sb.WriteString("\n")
+}
+
+// getCIReports fetches CI reports for sha, preferring git-appraise-style
+// notes and falling back to the gh CLI when a GitHub remote is detected.
+func (b *Builder) getCIReports(repoPath, sha string) []cistatus.Report {
+	reports, err := (cistatus.NotesProvider{}).GetReports(repoPath, sha)
+	if err == nil && len(reports) > 0 {
+		return reports
+	}
+
+	if cistatus.HasGitHubRemote(repoPath) {
+		if ghReports, err := (cistatus.GitHubProvider{}).GetReports(repoPath, sha); err == nil {
+			return ghReports
+		}
+	}
+
+	return nil
+}
+
 // getPreviousReviewContexts gets the N commits before the target and looks up their reviews
 func (b *Builder) getPreviousReviewContexts(repoPath, sha string, count int) ([]ReviewContext, error) {
 	// Get parent commits from git
@@ -189,12 +266,16 @@ func (b *Builder) getPreviousReviewContexts(repoPath, sha string, count int) ([]
 	for _, parentSHA := range parentSHAs {
 		ctx := ReviewContext{SHA: parentSHA}
 
-		// Try to look up review for this commit
+		// Try to look up review for this commit, falling back to git notes
+		// so context survives even if it was produced on another machine.
 		review, err := b.db.GetReviewByCommitSHA(parentSHA)
 		if err == nil {
 			ctx.Review = review
+		} else if noteReviews, nerr := notes.ListReviews(repoPath, parentSHA); nerr == nil && len(noteReviews) > 0 {
+			latest := noteReviews[len(noteReviews)-1]
+			ctx.Review = &storage.Review{Agent: latest.Agent, Output: latest.Output}
 		}
-		// If no review found, ctx.Review stays nil
+		// If no review found anywhere, ctx.Review stays nil
 
 		contexts = append(contexts, ctx)
 	}
@@ -0,0 +1,106 @@
+// Package reviewdoc parses the machine-readable block agents are asked to
+// emit alongside their prose review, so the TUI can show findings as a
+// navigable list instead of an opaque blob of text.
+package reviewdoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Severity is the importance of a finding, ordered worst to least.
+type Severity string
+
+const (
+	SeverityHigh   Severity = "high"
+	SeverityMedium Severity = "medium"
+	SeverityLow    Severity = "low"
+)
+
+// rank returns a sort weight for a severity, lower sorting first.
+func (s Severity) rank() int {
+	switch s {
+	case SeverityHigh:
+		return 0
+	case SeverityMedium:
+		return 1
+	case SeverityLow:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Finding is a single review comment tied to a location in the diff.
+type Finding struct {
+	Severity   Severity `json:"severity"`
+	File       string   `json:"file"`
+	LineStart  int      `json:"line_start"`
+	LineEnd    int      `json:"line_end"`
+	Category   string   `json:"category"`
+	Message    string   `json:"message"`
+	Suggestion string   `json:"suggestion"`
+}
+
+// Doc is the parsed contents of a ```roborev-json block.
+type Doc struct {
+	Findings []Finding `json:"findings"`
+	Summary  string    `json:"summary"`
+}
+
+var fencedBlock = regexp.MustCompile("(?s)```roborev-json\\s*\\n(.*?)\\n```")
+
+// Parse extracts and decodes the fenced roborev-json block from a review's
+// raw output. It returns an error if no block is present or it isn't valid
+// JSON, so callers can fall back to treating the output as plain prose.
+func Parse(output string) (*Doc, error) {
+	match := fencedBlock.FindStringSubmatch(output)
+	if match == nil {
+		return nil, fmt.Errorf("no roborev-json block found")
+	}
+
+	var doc Doc
+	if err := json.Unmarshal([]byte(strings.TrimSpace(match[1])), &doc); err != nil {
+		return nil, fmt.Errorf("decode roborev-json block: %w", err)
+	}
+
+	sortFindings(doc.Findings)
+	return &doc, nil
+}
+
+// sortFindings orders findings by severity (high first), then by file and
+// line so the list reads top-to-bottom the way a diff would.
+func sortFindings(findings []Finding) {
+	for i := 1; i < len(findings); i++ {
+		for j := i; j > 0 && less(findings[j], findings[j-1]); j-- {
+			findings[j], findings[j-1] = findings[j-1], findings[j]
+		}
+	}
+}
+
+func less(a, b Finding) bool {
+	if a.Severity.rank() != b.Severity.rank() {
+		return a.Severity.rank() < b.Severity.rank()
+	}
+	if a.File != b.File {
+		return a.File < b.File
+	}
+	return a.LineStart < b.LineStart
+}
+
+// Counts tallies findings by severity for display in a summary line.
+func Counts(findings []Finding) (high, medium, low int) {
+	for _, f := range findings {
+		switch f.Severity {
+		case SeverityHigh:
+			high++
+		case SeverityMedium:
+			medium++
+		case SeverityLow:
+			low++
+		}
+	}
+	return high, medium, low
+}
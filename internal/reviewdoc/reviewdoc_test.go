@@ -0,0 +1,68 @@
+package reviewdoc
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	output := "Looks mostly fine.\n\n```roborev-json\n" +
+		`{"summary":"one nit","findings":[` +
+		`{"severity":"low","file":"b.go","line_start":5,"message":"nit"},` +
+		`{"severity":"high","file":"a.go","line_start":10,"message":"bug"},` +
+		`{"severity":"high","file":"a.go","line_start":1,"message":"earlier bug"}` +
+		`]}` +
+		"\n```\n"
+
+	doc, err := Parse(output)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if doc.Summary != "one nit" {
+		t.Errorf("Summary = %q, want %q", doc.Summary, "one nit")
+	}
+	if len(doc.Findings) != 3 {
+		t.Fatalf("got %d findings, want 3", len(doc.Findings))
+	}
+
+	// high severity first, then ordered by file then line within a severity.
+	want := []struct {
+		file string
+		line int
+	}{
+		{"a.go", 1},
+		{"a.go", 10},
+		{"b.go", 5},
+	}
+	for i, w := range want {
+		f := doc.Findings[i]
+		if f.File != w.file || f.LineStart != w.line {
+			t.Errorf("Findings[%d] = %s:%d, want %s:%d", i, f.File, f.LineStart, w.file, w.line)
+		}
+	}
+}
+
+func TestParseNoBlock(t *testing.T) {
+	if _, err := Parse("just some prose, no fenced block"); err == nil {
+		t.Fatal("expected an error when no roborev-json block is present")
+	}
+}
+
+func TestParseMalformedJSON(t *testing.T) {
+	output := "```roborev-json\n{not valid json\n```"
+	if _, err := Parse(output); err == nil {
+		t.Fatal("expected an error for malformed JSON in the fenced block")
+	}
+}
+
+func TestCounts(t *testing.T) {
+	findings := []Finding{
+		{Severity: SeverityHigh},
+		{Severity: SeverityHigh},
+		{Severity: SeverityMedium},
+		{Severity: SeverityLow},
+		{Severity: SeverityLow},
+		{Severity: SeverityLow},
+	}
+	high, medium, low := Counts(findings)
+	if high != 2 || medium != 1 || low != 3 {
+		t.Errorf("Counts = (%d, %d, %d), want (2, 1, 3)", high, medium, low)
+	}
+}
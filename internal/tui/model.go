@@ -4,11 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/wesm/roborev/internal/git"
+	"github.com/wesm/roborev/internal/reviewdoc"
 	"github.com/wesm/roborev/internal/storage"
 )
 
@@ -31,6 +38,14 @@ var (
 	doneStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("46"))
 	failedStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
 
+	diffAddStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("46"))
+	diffDelStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	diffHunkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("51"))
+
+	approvedStyle         = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("46"))
+	changesRequestedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("196"))
+	dismissedStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("241"))
+
 	helpStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("241")).
 			MarginTop(1)
@@ -41,20 +56,41 @@ type view int
 const (
 	viewQueue view = iota
 	viewReview
+	viewFindings
+	viewDiff
+	viewVerdictNote
+)
+
+// Decision values for a human review verdict.
+const (
+	decisionApproved         = "approved"
+	decisionChangesRequested = "changes_requested"
+	decisionDismissed        = "dismissed"
 )
 
 // Model is the TUI model for the review queue
 type Model struct {
-	serverAddr    string
-	jobs          []storage.ReviewJob
-	status        storage.DaemonStatus
-	selectedIdx   int
-	currentView   view
-	currentReview *storage.Review
-	reviewScroll  int
-	width         int
-	height        int
-	err           error
+	serverAddr     string
+	jobs           []storage.ReviewJob
+	status         storage.DaemonStatus
+	selectedIdx    int
+	currentView    view
+	currentReview  *storage.Review
+	reviewScroll   int
+	findings       []reviewdoc.Finding
+	jobFindings    map[int64][]reviewdoc.Finding
+	findingsIdx    int
+	severityFilter reviewdoc.Severity
+	diffCache      map[int64][]git.Hunk
+	diffScroll     int
+	pendingScroll  *scrollTarget
+	verdicts       map[int64]string
+	verdictJobID   int64
+	verdictNote    textinput.Model
+	width          int
+	height         int
+	err            error
+	notesStatus    string
 }
 
 type tickMsg time.Time
@@ -62,6 +98,26 @@ type jobsMsg []storage.ReviewJob
 type statusMsg storage.DaemonStatus
 type reviewMsg *storage.Review
 type errMsg error
+type notesSyncedMsg string
+type diffMsg struct {
+	jobID int64
+	hunks []git.Hunk
+}
+type verdictSubmittedMsg struct {
+	jobID    int64
+	decision string
+}
+
+// scrollTarget is the file:line the diff view should land on once its
+// hunks are available, for when "d" is pressed before fetchDiff returns.
+type scrollTarget struct {
+	file string
+	line int
+}
+type jobFindingsMsg struct {
+	jobID    int64
+	findings []reviewdoc.Finding
+}
 
 // NewModel creates a new TUI model
 func NewModel(serverAddr string) Model {
@@ -69,6 +125,9 @@ func NewModel(serverAddr string) Model {
 		serverAddr:  serverAddr,
 		jobs:        []storage.ReviewJob{},
 		currentView: viewQueue,
+		diffCache:   map[int64][]git.Hunk{},
+		verdicts:    map[int64]string{},
+		jobFindings: map[int64][]reviewdoc.Finding{},
 		width:       80,
 		height:      24,
 	}
@@ -144,13 +203,136 @@ func (m Model) fetchReview(jobID int64) tea.Cmd {
 	}
 }
 
+// fetchJobFindings fetches and parses a completed job's review output in the
+// background, so renderQueueView can show finding counts aggregated across
+// the whole queue rather than just the review the user has open. Failures
+// are reported as zero findings for jobID rather than an errMsg, since this
+// runs silently behind the queue view and a parse/fetch miss for one job
+// shouldn't surface an error banner.
+func (m Model) fetchJobFindings(jobID int64) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := http.Get(fmt.Sprintf("%s/api/review?job_id=%d", m.serverAddr, jobID))
+		if err != nil {
+			return jobFindingsMsg{jobID: jobID}
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return jobFindingsMsg{jobID: jobID}
+		}
+
+		var review storage.Review
+		if err := json.NewDecoder(resp.Body).Decode(&review); err != nil {
+			return jobFindingsMsg{jobID: jobID}
+		}
+
+		var findings []reviewdoc.Finding
+		if doc, err := reviewdoc.Parse(review.Output); err == nil {
+			findings = doc.Findings
+		}
+		return jobFindingsMsg{jobID: jobID, findings: findings}
+	}
+}
+
+// syncNotes asks the daemon to pull review notes for the given job's repo
+// from its git remote, so context from other machines becomes available.
+func (m Model) syncNotes(jobID int64) tea.Cmd {
+	return func() tea.Msg {
+		resp, err := http.Post(fmt.Sprintf("%s/api/notes/sync?job_id=%d", m.serverAddr, jobID), "application/json", nil)
+		if err != nil {
+			return errMsg(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return errMsg(fmt.Errorf("sync notes: unexpected status %s", resp.Status))
+		}
+		return notesSyncedMsg("notes synced")
+	}
+}
+
+// fetchDiff fetches and parses the commit's diff for the diff view. It runs
+// git directly against the job's repo rather than through the daemon, since
+// the diff is only needed locally while the TUI is open.
+func (m Model) fetchDiff(job storage.ReviewJob) tea.Cmd {
+	return func() tea.Msg {
+		raw, err := git.GetDiff(job.RepoPath, job.GitRef)
+		if err != nil {
+			return errMsg(err)
+		}
+		hunks, err := git.ParseHunks(raw)
+		if err != nil {
+			return errMsg(err)
+		}
+		return diffMsg{jobID: job.ID, hunks: hunks}
+	}
+}
+
+// submitVerdict records a human decision on a review via the daemon, which
+// also appends it to the git-notes verdicts ref when that backend is
+// configured, so approvals propagate with the repo.
+func (m Model) submitVerdict(jobID int64, decision, note string) tea.Cmd {
+	return func() tea.Msg {
+		body, err := json.Marshal(struct {
+			Decision string `json:"decision"`
+			Note     string `json:"note"`
+		}{Decision: decision, Note: note})
+		if err != nil {
+			return errMsg(err)
+		}
+
+		url := fmt.Sprintf("%s/api/review/%d/verdict", m.serverAddr, jobID)
+		resp, err := http.Post(url, "application/json", strings.NewReader(string(body)))
+		if err != nil {
+			return errMsg(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return errMsg(fmt.Errorf("submit verdict: unexpected status %s", resp.Status))
+		}
+		return verdictSubmittedMsg{jobID: jobID, decision: decision}
+	}
+}
+
 // Update implements tea.Model
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.currentView == viewVerdictNote {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			switch keyMsg.String() {
+			case "esc":
+				m.currentView = viewReview
+				return m, nil
+			case "enter":
+				jobID := m.verdictJobID
+				note := m.verdictNote.Value()
+				m.verdicts[jobID] = decisionChangesRequested
+				m.currentView = viewReview
+				return m, m.submitVerdict(jobID, decisionChangesRequested, note)
+			}
+		}
+
+		// A tickMsg consumed here without re-arming m.tick() would stop the
+		// periodic fetchJobs/fetchStatus loop for good the first time a
+		// reviewer opens "request changes" and never resume after esc.
+		if _, ok := msg.(tickMsg); ok {
+			return m, tea.Batch(m.tick(), m.fetchJobs(), m.fetchStatus())
+		}
+
+		var cmd tea.Cmd
+		m.verdictNote, cmd = m.verdictNote.Update(msg)
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "q":
-			if m.currentView == viewReview {
+			switch m.currentView {
+			case viewFindings, viewDiff:
+				m.currentView = viewReview
+				return m, nil
+			case viewReview:
 				m.currentView = viewQueue
 				m.currentReview = nil
 				m.reviewScroll = 0
@@ -159,22 +341,38 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 
 		case "up", "k":
-			if m.currentView == viewQueue {
+			switch m.currentView {
+			case viewQueue:
 				if m.selectedIdx > 0 {
 					m.selectedIdx--
 				}
-			} else {
+			case viewFindings:
+				if m.findingsIdx > 0 {
+					m.findingsIdx--
+				}
+			case viewDiff:
+				if m.diffScroll > 0 {
+					m.diffScroll--
+				}
+			default:
 				if m.reviewScroll > 0 {
 					m.reviewScroll--
 				}
 			}
 
 		case "down", "j":
-			if m.currentView == viewQueue {
+			switch m.currentView {
+			case viewQueue:
 				if m.selectedIdx < len(m.jobs)-1 {
 					m.selectedIdx++
 				}
-			} else {
+			case viewFindings:
+				if m.findingsIdx < len(m.visibleFindings())-1 {
+					m.findingsIdx++
+				}
+			case viewDiff:
+				m.diffScroll++
+			default:
 				m.reviewScroll++
 			}
 
@@ -193,14 +391,94 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.reviewScroll = 0
 					return m, nil
 				}
+			} else if m.currentView == viewFindings {
+				findings := m.visibleFindings()
+				if m.findingsIdx < len(findings) && m.currentReview != nil && m.currentReview.Job != nil {
+					if err := openInEditor(m.currentReview.Job.RepoPath, findings[m.findingsIdx]); err != nil {
+						m.err = err
+					}
+				}
+			}
+
+		case "i":
+			if m.currentView == viewReview && len(m.findings) > 0 {
+				m.currentView = viewFindings
+				m.findingsIdx = 0
+			}
+
+		case "f":
+			if m.currentView == viewFindings {
+				m.severityFilter = nextSeverity(m.severityFilter)
+				m.findingsIdx = 0
+			}
+
+		case "F":
+			if m.currentView == viewFindings {
+				m.severityFilter = prevSeverity(m.severityFilter)
+				m.findingsIdx = 0
+			}
+
+		case "d":
+			if (m.currentView == viewReview || m.currentView == viewFindings) &&
+				m.currentReview != nil && m.currentReview.Job != nil {
+				job := *m.currentReview.Job
+				m.pendingScroll = nil
+				if m.currentView == viewFindings {
+					if findings := m.visibleFindings(); m.findingsIdx < len(findings) {
+						f := findings[m.findingsIdx]
+						m.pendingScroll = &scrollTarget{file: f.File, line: f.LineStart}
+					}
+				}
+				m.diffScroll = m.scrollForSelectedFinding()
+				m.currentView = viewDiff
+				if _, ok := m.diffCache[job.ID]; ok {
+					m.pendingScroll = nil
+					return m, nil
+				}
+				return m, m.fetchDiff(job)
 			}
 
 		case "esc":
-			if m.currentView == viewReview {
+			switch m.currentView {
+			case viewFindings, viewDiff:
+				m.currentView = viewReview
+			case viewReview:
 				m.currentView = viewQueue
 				m.currentReview = nil
 				m.reviewScroll = 0
 			}
+
+		case "n":
+			if m.currentView == viewQueue && len(m.jobs) > 0 {
+				job := m.jobs[m.selectedIdx]
+				m.notesStatus = "syncing notes..."
+				return m, m.syncNotes(job.ID)
+			}
+
+		case "a":
+			if m.currentView == viewReview && m.currentReview != nil && m.currentReview.Job != nil {
+				jobID := m.currentReview.Job.ID
+				m.verdicts[jobID] = decisionApproved
+				return m, m.submitVerdict(jobID, decisionApproved, "")
+			}
+
+		case "x":
+			if m.currentView == viewReview && m.currentReview != nil && m.currentReview.Job != nil {
+				jobID := m.currentReview.Job.ID
+				m.verdicts[jobID] = decisionDismissed
+				return m, m.submitVerdict(jobID, decisionDismissed, "")
+			}
+
+		case "r":
+			if m.currentView == viewReview && m.currentReview != nil && m.currentReview.Job != nil {
+				ti := textinput.New()
+				ti.Placeholder = "reason for requesting changes"
+				ti.Focus()
+				m.verdictNote = ti
+				m.verdictJobID = m.currentReview.Job.ID
+				m.currentView = viewVerdictNote
+				return m, nil
+			}
 		}
 
 	case tea.WindowSizeMsg:
@@ -216,16 +494,57 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.selectedIdx = max(0, len(m.jobs)-1)
 		}
 
+		var cmds []tea.Cmd
+		for _, job := range m.jobs {
+			if job.Status != storage.JobStatusDone {
+				continue
+			}
+			if _, ok := m.jobFindings[job.ID]; ok {
+				continue
+			}
+			cmds = append(cmds, m.fetchJobFindings(job.ID))
+		}
+		if len(cmds) > 0 {
+			return m, tea.Batch(cmds...)
+		}
+
 	case statusMsg:
 		m.status = storage.DaemonStatus(msg)
 
+	case jobFindingsMsg:
+		m.jobFindings[msg.jobID] = msg.findings
+
 	case reviewMsg:
 		m.currentReview = msg
 		m.currentView = viewReview
 		m.reviewScroll = 0
+		m.findingsIdx = 0
+		m.severityFilter = ""
+		if doc, err := reviewdoc.Parse(msg.Output); err == nil {
+			m.findings = doc.Findings
+		} else {
+			m.findings = nil
+		}
+		if msg.Job != nil {
+			m.jobFindings[msg.Job.ID] = m.findings
+		}
 
 	case errMsg:
 		m.err = msg
+
+	case notesSyncedMsg:
+		m.notesStatus = string(msg)
+
+	case diffMsg:
+		m.diffCache[msg.jobID] = msg.hunks
+		if m.pendingScroll != nil && m.currentReview != nil && m.currentReview.Job != nil &&
+			m.currentReview.Job.ID == msg.jobID {
+			m.diffScroll = diffScrollOffset(msg.hunks, m.pendingScroll.file, m.pendingScroll.line)
+			m.pendingScroll = nil
+		}
+
+	case verdictSubmittedMsg:
+		m.verdicts[msg.jobID] = msg.decision
 	}
 
 	return m, nil
@@ -233,31 +552,83 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // View implements tea.Model
 func (m Model) View() string {
-	if m.currentView == viewReview && m.currentReview != nil {
+	switch {
+	case m.currentView == viewVerdictNote:
+		return m.renderVerdictNoteView()
+	case m.currentView == viewDiff && m.currentReview != nil:
+		return m.renderDiffView()
+	case m.currentView == viewFindings && m.currentReview != nil:
+		return m.renderFindingsView()
+	case m.currentView == viewReview && m.currentReview != nil:
 		return m.renderReviewView()
 	}
 	return m.renderQueueView()
 }
 
+// verdictBadge renders a short colored label for a verdict decision, or ""
+// when there is none.
+func verdictBadge(decision string) string {
+	switch decision {
+	case decisionApproved:
+		return approvedStyle.Render("[approved]")
+	case decisionChangesRequested:
+		return changesRequestedStyle.Render("[changes requested]")
+	case decisionDismissed:
+		return dismissedStyle.Render("[dismissed]")
+	default:
+		return ""
+	}
+}
+
+func (m Model) renderVerdictNoteView() string {
+	var b strings.Builder
+
+	b.WriteString(titleStyle.Render("Request Changes"))
+	b.WriteString("\n")
+	b.WriteString(m.verdictNote.View())
+	b.WriteString("\n\n")
+	b.WriteString(helpStyle.Render("enter: submit | esc: cancel"))
+
+	return b.String()
+}
+
+// aggregateFindingCounts tallies findings by severity across every
+// completed job's review, not just the one currently open, so the queue
+// view's (H:.. M:.. L:..) summary reflects the whole queue.
+func (m Model) aggregateFindingCounts() (high, medium, low int) {
+	for _, findings := range m.jobFindings {
+		h, med, l := reviewdoc.Counts(findings)
+		high += h
+		medium += med
+		low += l
+	}
+	return high, medium, low
+}
+
 func (m Model) renderQueueView() string {
 	var b strings.Builder
 
 	b.WriteString(titleStyle.Render("RoboRev Queue"))
 	b.WriteString("\n")
 
-	statusLine := fmt.Sprintf("Workers: %d/%d | Queued: %d | Running: %d | Done: %d | Failed: %d | Size: %dx%d",
+	high, medium, low := m.aggregateFindingCounts()
+	statusLine := fmt.Sprintf("Workers: %d/%d | Queued: %d | Running: %d | Done: %d (H:%d M:%d L:%d) | Failed: %d | Size: %dx%d",
 		m.status.ActiveWorkers, m.status.MaxWorkers,
 		m.status.QueuedJobs, m.status.RunningJobs,
-		m.status.CompletedJobs, m.status.FailedJobs,
+		m.status.CompletedJobs, high, medium, low, m.status.FailedJobs,
 		m.width, m.height)
 	b.WriteString(statusStyle.Render(statusLine))
-	b.WriteString("\n\n")
+	b.WriteString("\n")
+	if m.notesStatus != "" {
+		b.WriteString(statusStyle.Render(m.notesStatus))
+	}
+	b.WriteString("\n")
 
 	if len(m.jobs) == 0 {
 		b.WriteString("No jobs in queue\n")
 	} else {
-		header := fmt.Sprintf("  %-4s %-17s %-15s %-12s %-8s %s",
-			"ID", "Ref", "Repo", "Agent", "Status", "Time")
+		header := fmt.Sprintf("  %-4s %-17s %-15s %-12s %-8s %-8s %s",
+			"ID", "Ref", "Repo", "Agent", "Status", "Verdict", "Time")
 		b.WriteString(statusStyle.Render(header))
 		b.WriteString("\n")
 		b.WriteString("  " + strings.Repeat("-", min(m.width-4, 78)))
@@ -303,7 +674,7 @@ func (m Model) renderQueueView() string {
 		}
 	}
 
-	b.WriteString(helpStyle.Render("up/down: navigate | enter: view review | q: quit"))
+	b.WriteString(helpStyle.Render("up/down: navigate | enter: view review | n: sync notes | q: quit"))
 
 	return b.String()
 }
@@ -352,8 +723,25 @@ func (m Model) renderJobLine(job storage.ReviewJob) string {
 		styledStatus += strings.Repeat(" ", padding)
 	}
 
-	return fmt.Sprintf("%-4d %-17s %-15s %-12s %s %s",
-		job.ID, ref, repo, agent, styledStatus, elapsed)
+	verdict := verdictAbbrev(m.verdicts[job.ID])
+
+	return fmt.Sprintf("%-4d %-17s %-15s %-12s %s %-8s %s",
+		job.ID, ref, repo, agent, styledStatus, verdict, elapsed)
+}
+
+// verdictAbbrev renders a short colored column value for a job's verdict,
+// or a blank placeholder when there is none.
+func verdictAbbrev(decision string) string {
+	switch decision {
+	case decisionApproved:
+		return approvedStyle.Render("approved")
+	case decisionChangesRequested:
+		return changesRequestedStyle.Render("changes")
+	case decisionDismissed:
+		return dismissedStyle.Render("dismissed")
+	default:
+		return ""
+	}
 }
 
 func wrapText(text string, width int) []string {
@@ -398,6 +786,9 @@ func (m Model) renderReviewView() string {
 			ref = ref[:17]
 		}
 		title := fmt.Sprintf("Review: %s (%s)", ref, review.Agent)
+		if badge := verdictBadge(m.verdicts[review.Job.ID]); badge != "" {
+			title += " " + badge
+		}
 		b.WriteString(titleStyle.Render(title))
 	} else {
 		b.WriteString(titleStyle.Render("Review"))
@@ -426,7 +817,302 @@ func (m Model) renderReviewView() string {
 		b.WriteString("\n")
 	}
 
+	help := "up/down: scroll | d: diff | a/r/x: approve/request changes/dismiss | esc/q: back"
+	if len(m.findings) > 0 {
+		help = "up/down: scroll | i: inspect findings | d: diff | a/r/x: approve/request changes/dismiss | esc/q: back"
+	}
+	b.WriteString(helpStyle.Render(help))
+
+	return b.String()
+}
+
+// visibleFindings returns m.findings filtered by m.severityFilter, or all
+// findings when no filter is set.
+func (m Model) visibleFindings() []reviewdoc.Finding {
+	if m.severityFilter == "" {
+		return m.findings
+	}
+	var filtered []reviewdoc.Finding
+	for _, f := range m.findings {
+		if f.Severity == m.severityFilter {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
+
+var severityOrder = []reviewdoc.Severity{"", reviewdoc.SeverityHigh, reviewdoc.SeverityMedium, reviewdoc.SeverityLow}
+
+func nextSeverity(s reviewdoc.Severity) reviewdoc.Severity {
+	for i, sev := range severityOrder {
+		if sev == s {
+			return severityOrder[(i+1)%len(severityOrder)]
+		}
+	}
+	return ""
+}
+
+func prevSeverity(s reviewdoc.Severity) reviewdoc.Severity {
+	for i, sev := range severityOrder {
+		if sev == s {
+			return severityOrder[(i-1+len(severityOrder))%len(severityOrder)]
+		}
+	}
+	return ""
+}
+
+func severityStyle(s reviewdoc.Severity) lipgloss.Style {
+	switch s {
+	case reviewdoc.SeverityHigh:
+		return failedStyle
+	case reviewdoc.SeverityMedium:
+		return queuedStyle
+	default:
+		return doneStyle
+	}
+}
+
+// openInEditor opens a finding's file at its starting line in $EDITOR,
+// defaulting to vi if unset. Finding paths are relative to the reviewed
+// job's own repo, which may not be the TUI process's working directory (the
+// daemon reviews jobs across different repos), so repoPath is joined in.
+func openInEditor(repoPath string, f reviewdoc.Finding) error {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+	target := fmt.Sprintf("+%d", f.LineStart)
+	path := f.File
+	if repoPath != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(repoPath, path)
+	}
+	cmd := exec.Command(editor, target, path)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (m Model) renderFindingsView() string {
+	var b strings.Builder
+
+	filterLabel := "all"
+	if m.severityFilter != "" {
+		filterLabel = string(m.severityFilter)
+	}
+	b.WriteString(titleStyle.Render(fmt.Sprintf("Findings (%s)", filterLabel)))
+	b.WriteString("\n")
+
+	findings := m.visibleFindings()
+	if len(findings) == 0 {
+		b.WriteString("No findings\n")
+	} else {
+		for i, f := range findings {
+			line := fmt.Sprintf("[%s] %s:%d %s", f.Severity, f.File, f.LineStart, f.Message)
+			line = severityStyle(f.Severity).Render(line)
+			if i == m.findingsIdx {
+				line = selectedStyle.Render("> ") + line
+			} else {
+				line = "  " + line
+			}
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString(helpStyle.Render("up/down: navigate | enter: open in $EDITOR | f/F: filter severity | d: diff | esc/q: back"))
+
+	return b.String()
+}
+
+// scrollForSelectedFinding returns the diff row offset for the finding
+// currently selected in the findings view, so toggling into the diff view
+// lands on the referenced file:line instead of the top of the diff.
+func (m Model) scrollForSelectedFinding() int {
+	if m.currentView != viewFindings {
+		return 0
+	}
+	findings := m.visibleFindings()
+	if m.findingsIdx >= len(findings) {
+		return 0
+	}
+	selected := findings[m.findingsIdx]
+
+	job := m.currentReview.Job
+	if job == nil {
+		return 0
+	}
+	hunks, ok := m.diffCache[job.ID]
+	if !ok {
+		return 0
+	}
+
+	return diffScrollOffset(hunks, selected.File, selected.LineStart)
+}
+
+// diffScrollOffset returns the row offset within hunks' flattened rows where
+// file:line first appears, or 0 if no hunk covers it.
+func diffScrollOffset(hunks []git.Hunk, file string, line int) int {
+	offset := 0
+	for _, h := range hunks {
+		if h.File == file && h.Contains(line) {
+			newLine := h.NewStart
+			for i, l := range h.Lines {
+				if i == 0 {
+					continue
+				}
+				if newLine == line {
+					return offset + i
+				}
+				if strings.HasPrefix(l, "+") || strings.HasPrefix(l, " ") {
+					newLine++
+				}
+			}
+			return offset
+		}
+		offset += len(h.Lines)
+	}
+	return 0
+}
+
+// diffRow is one row of the side-by-side diff view: a line of the unified
+// diff paired with the finding (if any) whose starting line it is.
+type diffRow struct {
+	line    string
+	finding reviewdoc.Finding
+	hasFind bool
+}
+
+// pairDiffRows flattens hunks into rows and pairs each row with the finding
+// that references its file:line, so the diff viewer can show review findings
+// next to the hunks they're about.
+func pairDiffRows(hunks []git.Hunk, findings []reviewdoc.Finding) []diffRow {
+	byFileLine := make(map[string]reviewdoc.Finding, len(findings))
+	for _, f := range findings {
+		byFileLine[fmt.Sprintf("%s:%d", f.File, f.LineStart)] = f
+	}
+
+	var rows []diffRow
+	for _, h := range hunks {
+		newLine := h.NewStart
+		for i, l := range h.Lines {
+			row := diffRow{line: l}
+			if i > 0 && (strings.HasPrefix(l, "+") || strings.HasPrefix(l, " ")) {
+				if f, ok := byFileLine[fmt.Sprintf("%s:%d", h.File, newLine)]; ok {
+					row.finding, row.hasFind = f, true
+				}
+				newLine++
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// renderDiffView renders the commit's unified diff on the left, paired on
+// the right with any review finding that references the line alongside it.
+func (m Model) renderDiffView() string {
+	var b strings.Builder
+
+	var title string
+	if m.currentReview.Job != nil {
+		title = fmt.Sprintf("Diff: %s", m.currentReview.Job.GitRef)
+	} else {
+		title = "Diff"
+	}
+	b.WriteString(titleStyle.Render(title))
+	b.WriteString("\n")
+
+	var hunks []git.Hunk
+	if m.currentReview.Job != nil {
+		hunks = m.diffCache[m.currentReview.Job.ID]
+	}
+
+	if hunks == nil {
+		b.WriteString("Loading diff...\n")
+		b.WriteString(helpStyle.Render("esc/q: back"))
+		return b.String()
+	}
+
+	rows := pairDiffRows(hunks, m.findings)
+
+	leftWidth := (m.width - 3) / 2
+	if leftWidth < 20 {
+		leftWidth = 20
+	}
+	rightWidth := m.width - leftWidth - 3
+	if rightWidth < 10 {
+		rightWidth = 10
+	}
+
+	header := fmt.Sprintf("%-*s │ %s", leftWidth, "Diff", "Findings")
+	b.WriteString(statusStyle.Render(header))
+	b.WriteString("\n")
+
+	visibleLines := m.height - 5
+	start := m.diffScroll
+	if start >= len(rows) {
+		start = max(0, len(rows)-1)
+	}
+	end := min(start+visibleLines, len(rows))
+
+	for i := start; i < end; i++ {
+		row := rows[i]
+
+		rawLeft := truncateLine(row.line, leftWidth)
+		left := diffLineStyle(row.line).Render(rawLeft)
+		if pad := leftWidth - utf8.RuneCountInString(rawLeft); pad > 0 {
+			left += strings.Repeat(" ", pad)
+		}
+
+		right := ""
+		if row.hasFind {
+			text := fmt.Sprintf("[%s] %s", row.finding.Severity, row.finding.Message)
+			right = severityStyle(row.finding.Severity).Render(truncateLine(text, rightWidth))
+		}
+
+		b.WriteString(left)
+		b.WriteString(" │ ")
+		b.WriteString(right)
+		b.WriteString("\n")
+	}
+
+	if len(rows) > visibleLines {
+		scrollInfo := fmt.Sprintf("[%d-%d of %d lines]", start+1, end, len(rows))
+		b.WriteString(statusStyle.Render(scrollInfo))
+		b.WriteString("\n")
+	}
+
 	b.WriteString(helpStyle.Render("up/down: scroll | esc/q: back"))
 
 	return b.String()
 }
+
+// truncateLine clips s to width runes of display width, for laying out
+// fixed-width columns before ANSI styling is applied.
+func truncateLine(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	r := []rune(s)
+	if len(r) <= width {
+		return s
+	}
+	return string(r[:width])
+}
+
+// diffLineStyle returns the foreground style for a unified-diff line based
+// on its leading character: additions green, deletions red, hunk headers
+// cyan.
+func diffLineStyle(line string) lipgloss.Style {
+	switch {
+	case strings.HasPrefix(line, "@@"):
+		return diffHunkStyle
+	case strings.HasPrefix(line, "+"):
+		return diffAddStyle
+	case strings.HasPrefix(line, "-"):
+		return diffDelStyle
+	default:
+		return lipgloss.NewStyle()
+	}
+}